@@ -0,0 +1,30 @@
+package azure
+
+// MachinePool stores the configuration for a machine pool installed on Azure.
+type MachinePool struct {
+	// Zones is list of availability zones that can be used.
+	// eg. ["1", "2", "3"]
+	//
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// InstanceType defines the azure instance type.
+	// eg. Standard_DS_V2
+	//
+	// +optional
+	InstanceType string `json:"type,omitempty"`
+
+	// Identity overrides the platform-level managed identity for the nodes in this machine
+	// pool. When not specified, the platform-level Identity is used.
+	//
+	// +optional
+	Identity *Identity `json:"identity,omitempty"`
+}
+
+// Validate checks that the machine pool's Azure-specific fields are internally consistent.
+func (mp *MachinePool) Validate() error {
+	if mp.Identity != nil {
+		return mp.Identity.Validate()
+	}
+	return nil
+}
@@ -1,9 +1,13 @@
 package azure
 
-import "strings"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // OutboundType is a strategy for how egress from cluster is achieved.
-// +kubebuilder:validation:Enum="";Loadbalancer;UserDefinedRouting
+// +kubebuilder:validation:Enum="";Loadbalancer;UserDefinedRouting;NATGateway
 type OutboundType string
 
 const (
@@ -14,6 +18,12 @@ const (
 	// UserDefinedRoutingOutboundType uses user defined routing for egress from the cluster.
 	// see https://docs.microsoft.com/en-us/azure/virtual-network/virtual-networks-udr-overview
 	UserDefinedRoutingOutboundType OutboundType = "UserDefinedRouting"
+
+	// NATGatewayOutboundType uses an Azure NAT Gateway for egress from the cluster instead of
+	// the outbound rules of the Standard loadbalancer. This avoids SNAT port exhaustion on
+	// large clusters and is the Azure-recommended egress path for production workloads.
+	// see https://docs.microsoft.com/en-us/azure/virtual-network/nat-gateway/nat-overview
+	NATGatewayOutboundType OutboundType = "NATGateway"
 )
 
 // Platform stores all the global configuration that all machinesets
@@ -62,6 +72,137 @@ type Platform struct {
 	// +kubebuilder:default=Loadbalancer
 	// +optional
 	OutboundType OutboundType `json:"outboundType"`
+
+	// Identity is the configuration of the managed identity assigned to the control-plane and
+	// worker nodes of the cluster. When not specified, nodes are created with a SystemAssigned
+	// identity.
+	//
+	// +optional
+	Identity *Identity `json:"identity,omitempty"`
+
+	// PrivateDNSZoneResourceGroupName specifies the resource group that contains an existing
+	// Private DNS Zone for the cluster's api and *.apps records. It is only consulted when the
+	// cluster is Publish=Internal. When not specified, the installer creates the Private DNS
+	// Zone in the cluster's own resource group.
+	//
+	// +optional
+	PrivateDNSZoneResourceGroupName string `json:"privateDNSZoneResourceGroupName,omitempty"`
+
+	// APIServerPrivateLink, when true, additionally fronts the API server with an Azure Private
+	// Link Service endpoint so it can be consumed from peered or on-premises networks without
+	// exposing a public IP. It is only valid for Publish=Internal clusters.
+	//
+	// +optional
+	APIServerPrivateLink bool `json:"apiServerPrivateLink,omitempty"`
+
+	// NATGateway holds the configuration for egress when OutboundType is NATGateway.
+	//
+	// +optional
+	NATGateway *NATGateway `json:"natGateway,omitempty"`
+}
+
+// NATGateway holds the configuration of the Azure NAT Gateway used for cluster egress when
+// OutboundType is NATGateway.
+type NATGateway struct {
+	// IdleTimeoutInMinutes specifies the idle connection timeout, in minutes, for the NAT
+	// Gateway's outbound flows.
+	//
+	// +kubebuilder:default=4
+	// +optional
+	IdleTimeoutInMinutes int32 `json:"idleTimeoutInMinutes,omitempty"`
+
+	// Zones restricts the NAT Gateway, and the public IP(s) it uses, to the given list of
+	// availability zones. When not specified, the NAT Gateway is zone-redundant.
+	//
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// PublicIPPrefixResourceID is the resource ID of an existing public IP prefix to source the
+	// NAT Gateway's outbound IP addresses from. When not specified, the installer creates a new
+	// public IP for the NAT Gateway.
+	//
+	// +optional
+	PublicIPPrefixResourceID string `json:"publicIPPrefixResourceID,omitempty"`
+}
+
+// IdentityType is the type of managed identity to assign to an Azure compute instance.
+// +kubebuilder:validation:Enum="";SystemAssigned;UserAssigned;WorkloadIdentity
+type IdentityType string
+
+const (
+	// SystemAssignedIdentityType has Azure create and manage an identity tied to the lifecycle
+	// of the instance it is assigned to.
+	SystemAssignedIdentityType IdentityType = "SystemAssigned"
+
+	// UserAssignedIdentityType assigns one or more pre-existing Azure user-assigned managed
+	// identities to the instance.
+	UserAssignedIdentityType IdentityType = "UserAssigned"
+
+	// WorkloadIdentityType federates a Kubernetes service account with Azure AD via an OIDC
+	// issuer, avoiding the need for a service principal or a managed identity altogether.
+	WorkloadIdentityType IdentityType = "WorkloadIdentity"
+)
+
+// Identity describes the managed identity to use for the cluster's control-plane and worker
+// nodes in place of a service-principal credential.
+type Identity struct {
+	// Type selects which kind of identity is assigned to the nodes.
+	//
+	// +kubebuilder:default=SystemAssigned
+	// +optional
+	Type IdentityType `json:"type,omitempty"`
+
+	// UserAssignedIdentities is the list of resource IDs of existing Azure user-assigned managed
+	// identities to attach to the nodes. Required when Type is UserAssigned.
+	//
+	// +optional
+	UserAssignedIdentities []string `json:"userAssignedIdentities,omitempty"`
+
+	// WorkloadIdentity holds the federated OIDC configuration used to authenticate the nodes
+	// against Azure AD. Required when Type is WorkloadIdentity.
+	//
+	// +optional
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+// Validate checks that the identity configuration is internally consistent for the selected
+// Type, e.g. that UserAssignedIdentities is populated when Type is UserAssigned.
+func (i *Identity) Validate() error {
+	switch i.Type {
+	case UserAssignedIdentityType:
+		if len(i.UserAssignedIdentities) == 0 {
+			return errors.New("userAssignedIdentities must be set when identity type is UserAssigned")
+		}
+	case WorkloadIdentityType:
+		if i.WorkloadIdentity == nil {
+			return errors.New("workloadIdentity must be set when identity type is WorkloadIdentity")
+		}
+		if i.WorkloadIdentity.IssuerURL == "" {
+			return errors.New("workloadIdentity.issuerURL must be set when identity type is WorkloadIdentity")
+		}
+		if i.WorkloadIdentity.ServiceAccountName == "" {
+			return errors.New("workloadIdentity.serviceAccountName must be set when identity type is WorkloadIdentity")
+		}
+		if i.WorkloadIdentity.ServiceAccountNamespace == "" {
+			return errors.New("workloadIdentity.serviceAccountNamespace must be set when identity type is WorkloadIdentity")
+		}
+	}
+	return nil
+}
+
+// WorkloadIdentity holds the OIDC federation details needed for Azure AD workload identity.
+type WorkloadIdentity struct {
+	// IssuerURL is the URL of the OIDC issuer that Azure AD will trust when exchanging tokens
+	// for the federated credential.
+	IssuerURL string `json:"issuerURL"`
+
+	// ServiceAccountName is the name of the Kubernetes ServiceAccount the federated credential
+	// is scoped to.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// ServiceAccountNamespace is the namespace of the Kubernetes ServiceAccount the federated
+	// credential is scoped to.
+	ServiceAccountNamespace string `json:"serviceAccountNamespace"`
 }
 
 // CloudEnvironment is the name of the Azure cloud environment
@@ -94,3 +235,50 @@ func (p *Platform) SetBaseDomain(baseDomainID string) error {
 	p.BaseDomainResourceGroupName = parts[4]
 	return nil
 }
+
+// Validate checks that the platform's fields are set to permissible values.
+func (p *Platform) Validate() error {
+	if p.Identity != nil {
+		if err := p.Identity.Validate(); err != nil {
+			return fmt.Errorf("identity: %w", err)
+		}
+	}
+	if p.DefaultMachinePlatform != nil {
+		if err := p.DefaultMachinePlatform.Validate(); err != nil {
+			return fmt.Errorf("defaultMachinePlatform: %w", err)
+		}
+	}
+	if err := p.validatePrivateLink(); err != nil {
+		return err
+	}
+	if err := p.validateNATGateway(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateNATGateway checks that NATGateway is configured when it is the selected OutboundType.
+func (p *Platform) validateNATGateway() error {
+	if p.OutboundType == NATGatewayOutboundType && p.NATGateway == nil {
+		return errors.New("natGateway must be set when outboundType is NATGateway")
+	}
+	return nil
+}
+
+// validatePrivateLink checks that the private-cluster fields are only used in combinations the
+// installer can actually wire up.
+//
+// Note: this does not reject Publish=External combined with OutboundType=UserDefinedRouting and
+// no egress path (NAT gateway or firewall) configured in the existing VNet, even though that
+// combination leaves the cluster unable to reach the internet for image pulls and the Azure API.
+// Platform has no access to the install config's Publish setting from this package in this tree,
+// so that check belongs with whatever validates the install config as a whole, not here.
+func (p *Platform) validatePrivateLink() error {
+	if p.PrivateDNSZoneResourceGroupName != "" && p.VirtualNetwork == "" {
+		return errors.New("privateDNSZoneResourceGroupName requires virtualNetwork to be set, since the Private DNS Zone is linked to that existing VNet")
+	}
+	if p.APIServerPrivateLink && p.VirtualNetwork == "" {
+		return errors.New("apiServerPrivateLink requires virtualNetwork to be set, since the Private Link Service endpoint is attached to that existing VNet")
+	}
+	return nil
+}
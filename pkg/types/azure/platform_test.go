@@ -0,0 +1,101 @@
+package azure
+
+import "testing"
+
+func TestIdentityValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		id      Identity
+		wantErr bool
+	}{
+		{
+			name: "unset type is valid",
+			id:   Identity{},
+		},
+		{
+			name: "system assigned is valid",
+			id:   Identity{Type: SystemAssignedIdentityType},
+		},
+		{
+			name:    "user assigned without identities is invalid",
+			id:      Identity{Type: UserAssignedIdentityType},
+			wantErr: true,
+		},
+		{
+			name: "user assigned with identities is valid",
+			id: Identity{
+				Type:                   UserAssignedIdentityType,
+				UserAssignedIdentities: []string{"/subscriptions/foo/resourceGroups/bar/.../baz"},
+			},
+		},
+		{
+			name:    "workload identity without workloadIdentity is invalid",
+			id:      Identity{Type: WorkloadIdentityType},
+			wantErr: true,
+		},
+		{
+			name: "workload identity with empty subfields is invalid",
+			id: Identity{
+				Type:             WorkloadIdentityType,
+				WorkloadIdentity: &WorkloadIdentity{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "workload identity missing issuerURL is invalid",
+			id: Identity{
+				Type: WorkloadIdentityType,
+				WorkloadIdentity: &WorkloadIdentity{
+					ServiceAccountName:      "default",
+					ServiceAccountNamespace: "openshift-cloud-credential-operator",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "workload identity missing serviceAccountName is invalid",
+			id: Identity{
+				Type: WorkloadIdentityType,
+				WorkloadIdentity: &WorkloadIdentity{
+					IssuerURL:               "https://issuer.example.com",
+					ServiceAccountNamespace: "openshift-cloud-credential-operator",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "workload identity missing serviceAccountNamespace is invalid",
+			id: Identity{
+				Type: WorkloadIdentityType,
+				WorkloadIdentity: &WorkloadIdentity{
+					IssuerURL:          "https://issuer.example.com",
+					ServiceAccountName: "default",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fully populated workload identity is valid",
+			id: Identity{
+				Type: WorkloadIdentityType,
+				WorkloadIdentity: &WorkloadIdentity{
+					IssuerURL:               "https://issuer.example.com",
+					ServiceAccountName:      "default",
+					ServiceAccountNamespace: "openshift-cloud-credential-operator",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.id.Validate()
+			if tc.wantErr && err == nil {
+				t.Errorf("Validate() = nil, want error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceObjectStorageObjectV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageObjectV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_static_large_object": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	result := objects.Get(objectStorageClient, container, name, nil)
+	if result.Err != nil {
+		return fmt.Errorf("error reading objectstorage_object_v1 '%s/%s': %s", container, name, result.Err)
+	}
+
+	header, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("error extracting headers for objectstorage_object_v1 '%s/%s': %s", container, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", container, name))
+	d.Set("content_type", header.ContentType)
+	d.Set("etag", header.ETag)
+	d.Set("is_static_large_object", result.Header.Get("X-Static-Large-Object") == "True")
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
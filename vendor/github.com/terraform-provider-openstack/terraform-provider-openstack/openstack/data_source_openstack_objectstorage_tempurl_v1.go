@@ -0,0 +1,104 @@
+package openstack
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceObjectStorageTempurlV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageTempurlV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"container": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"object": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "GET",
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET", "PUT", "HEAD",
+				}, false),
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageTempurlV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container").(string)
+	object := d.Get("object").(string)
+	method := d.Get("method").(string)
+	key := d.Get("key").(string)
+	expires := time.Now().Unix() + int64(d.Get("ttl").(int))
+
+	endpoint, err := url.Parse(objectStorageClient.Endpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing object storage endpoint '%s': %s", objectStorageClient.Endpoint, err)
+	}
+	path := fmt.Sprintf("%s/%s/%s", strings.TrimRight(endpoint.Path, "/"), container, object)
+
+	signature := tempurlSignatureV1(method, expires, path, key)
+
+	d.SetId(fmt.Sprintf("%s/%s", container, object))
+	d.Set("region", GetRegion(d, config))
+	d.Set("expires", expires)
+	d.Set("url", fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", endpoint.Scheme+"://"+endpoint.Host, path, signature, expires))
+
+	return nil
+}
+
+// tempurlSignatureV1 computes a Swift TempURL signature: an HMAC-SHA1, hex-encoded, over
+// "method\nexpires\npath" keyed with the container or account's Temp-URL-Key.
+// See https://docs.openstack.org/swift/latest/api/temporary_url_middleware.html
+func tempurlSignatureV1(method string, expires int64, path, key string) string {
+	body := strings.Join([]string{method, strconv.FormatInt(expires, 10), path}, "\n")
+
+	mac := hmac.New(sha1.New, []byte(key))
+	mac.Write([]byte(body))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
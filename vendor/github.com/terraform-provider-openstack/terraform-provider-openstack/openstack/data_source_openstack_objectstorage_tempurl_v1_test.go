@@ -0,0 +1,20 @@
+package openstack
+
+import "testing"
+
+func TestTempurlSignatureV1(t *testing.T) {
+	got := tempurlSignatureV1("GET", 1000000, "/v1/AUTH_test/container/object", "testkey")
+	want := "c7d6d5a603af0eb2224b39a76114cccb063d8532"
+
+	if got != want {
+		t.Errorf("tempurlSignatureV1(...) = %q, want %q", got, want)
+	}
+
+	if other := tempurlSignatureV1("PUT", 1000000, "/v1/AUTH_test/container/object", "testkey"); other == got {
+		t.Error("tempurlSignatureV1 returned the same signature for different methods")
+	}
+
+	if other := tempurlSignatureV1("GET", 1000000, "/v1/AUTH_test/container/object", "otherkey"); other == got {
+		t.Error("tempurlSignatureV1 returned the same signature for different keys")
+	}
+}
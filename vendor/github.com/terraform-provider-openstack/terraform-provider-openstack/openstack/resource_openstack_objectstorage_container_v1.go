@@ -1,9 +1,13 @@
 package openstack
 
 import (
+	"bytes"
 	"fmt"
 	"log"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
@@ -13,6 +17,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
+// containerExtraHeadersUpdateOpts wraps a containers.UpdateOptsBuilder to additionally set
+// headers that have no matching field on containers.CreateOpts/UpdateOpts: the X-Versions-Enabled
+// header used by Swift's object_versioning mode, and the X-Container-Meta-Temp-URL-Key[-2]
+// metadata used to sign tempurls. Both have to be injected by hand.
+type containerExtraHeadersUpdateOpts struct {
+	containers.UpdateOptsBuilder
+	headers map[string]string
+}
+
+func (opts containerExtraHeadersUpdateOpts) ToContainerUpdateMap() (map[string]string, error) {
+	h, err := opts.UpdateOptsBuilder.ToContainerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range opts.headers {
+		h[k] = v
+	}
+	return h, nil
+}
+
 func resourceObjectStorageContainerV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceObjectStorageContainerV1Create,
@@ -60,6 +84,23 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"versioning_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+			},
+			"tempurl_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+			"tempurl_key2": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
 			"versioning": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -90,6 +131,11 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"force_destroy_concurrency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
+			},
 		},
 	}
 }
@@ -132,6 +178,26 @@ func resourceObjectStorageContainerV1Create(d *schema.ResourceData, meta interfa
 	}
 	log.Printf("[INFO] objectstorage_container_v1 created with ID: %s", cn)
 
+	extraHeaders := map[string]string{}
+	if d.Get("versioning_enabled").(bool) {
+		extraHeaders["X-Versions-Enabled"] = "true"
+	}
+	if v := d.Get("tempurl_key").(string); v != "" {
+		extraHeaders["X-Container-Meta-Temp-URL-Key"] = v
+	}
+	if v := d.Get("tempurl_key2").(string); v != "" {
+		extraHeaders["X-Container-Meta-Temp-URL-Key-2"] = v
+	}
+	if len(extraHeaders) > 0 {
+		updateOpts := containerExtraHeadersUpdateOpts{
+			UpdateOptsBuilder: containers.UpdateOpts{},
+			headers:           extraHeaders,
+		}
+		if _, err := containers.Update(objectStorageClient, cn, updateOpts).Extract(); err != nil {
+			return fmt.Errorf("error setting versioning/tempurl headers for objectstorage_container_v1 '%s': %s", cn, err)
+		}
+	}
+
 	// Store the ID now
 	d.SetId(cn)
 
@@ -174,6 +240,27 @@ func resourceObjectStorageContainerV1Read(d *schema.ResourceData, meta interface
 		d.Set("container_write", strings.Join(headers.Write, ","))
 	}
 
+	d.Set("container_sync_to", headers.SyncTo)
+	d.Set("container_sync_key", headers.SyncKey)
+	d.Set("content_type", headers.ContentType)
+
+	// Temp-URL-Key[-2] are container metadata (X-Container-Meta-Temp-URL-Key[-2]), so they come
+	// back through the metadata map rather than as typed fields on containers.GetHeaders.
+	if v, ok := metadata["Temp-Url-Key"]; ok {
+		d.Set("tempurl_key", v)
+	}
+	if v, ok := metadata["Temp-Url-Key-2"]; ok {
+		d.Set("tempurl_key2", v)
+	}
+
+	if v := result.Header.Get("X-Versions-Enabled"); v != "" {
+		versioningEnabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("error parsing X-Versions-Enabled header for objectstorage_container_v1 '%s': %s", d.Id(), err)
+		}
+		d.Set("versioning_enabled", versioningEnabled)
+	}
+
 	versioningResource := resourceObjectStorageContainerV1().Schema["versioning"].Elem.(*schema.Resource)
 
 	if headers.VersionsLocation != "" && headers.HistoryLocation != "" {
@@ -246,7 +333,25 @@ func resourceObjectStorageContainerV1Update(d *schema.ResourceData, meta interfa
 		updateOpts.Metadata = resourceContainerMetadataV2(d)
 	}
 
-	_, err = containers.Update(objectStorageClient, d.Id(), updateOpts).Extract()
+	extraHeaders := map[string]string{}
+	if d.HasChange("versioning_enabled") {
+		extraHeaders["X-Versions-Enabled"] = strconv.FormatBool(d.Get("versioning_enabled").(bool))
+	}
+	if d.HasChange("tempurl_key") {
+		extraHeaders["X-Container-Meta-Temp-URL-Key"] = d.Get("tempurl_key").(string)
+	}
+	if d.HasChange("tempurl_key2") {
+		extraHeaders["X-Container-Meta-Temp-URL-Key-2"] = d.Get("tempurl_key2").(string)
+	}
+
+	if len(extraHeaders) > 0 {
+		_, err = containers.Update(objectStorageClient, d.Id(), containerExtraHeadersUpdateOpts{
+			UpdateOptsBuilder: updateOpts,
+			headers:           extraHeaders,
+		}).Extract()
+	} else {
+		_, err = containers.Update(objectStorageClient, d.Id(), updateOpts).Extract()
+	}
 	if err != nil {
 		return fmt.Errorf("error updating objectstorage_container_v1 '%s': %s", d.Id(), err)
 	}
@@ -268,28 +373,8 @@ func resourceObjectStorageContainerV1Delete(d *schema.ResourceData, meta interfa
 			// Container may have things. Delete them.
 			log.Printf("[DEBUG] Attempting to forceDestroy objectstorage_container_v1 '%s': %+v", d.Id(), err)
 
-			container := d.Id()
-			opts := &objects.ListOpts{
-				Full: false,
-			}
-			// Retrieve a pager (i.e. a paginated collection)
-			pager := objects.List(objectStorageClient, container, opts)
-			// Define an anonymous function to be executed on each page's iteration
-			err := pager.EachPage(func(page pagination.Page) (bool, error) {
-
-				objectList, err := objects.ExtractNames(page)
-				if err != nil {
-					return false, fmt.Errorf("error extracting names from objects from page for objectstorage_container_v1 '%s': %+v", container, err)
-				}
-				for _, object := range objectList {
-					_, err = objects.Delete(objectStorageClient, container, object, objects.DeleteOpts{}).Extract()
-					if err != nil {
-						return false, fmt.Errorf("error deleting object '%s' from objectstorage_container_v1 '%s': %+v", object, container, err)
-					}
-				}
-				return true, nil
-			})
-			if err != nil {
+			concurrency := d.Get("force_destroy_concurrency").(int)
+			if err := resourceObjectStorageContainerV1ForceDestroy(objectStorageClient, d.Id(), concurrency); err != nil {
 				return err
 			}
 			return resourceObjectStorageContainerV1Delete(d, meta)
@@ -301,6 +386,160 @@ func resourceObjectStorageContainerV1Delete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// bulkDeleteBatchSize is the maximum number of object paths Swift's bulk-delete middleware
+// accepts in a single request.
+const bulkDeleteBatchSize = 1000
+
+// resourceObjectStorageContainerV1ForceDestroy empties container of all objects, preferring
+// Swift's bulk-delete middleware when the cluster advertises it and falling back to a bounded
+// pool of per-object deletes otherwise. It then recurses into the container's "_segments"
+// companion container, if any, so SLO/DLO segments are cleaned up too.
+func resourceObjectStorageContainerV1ForceDestroy(client *gophercloud.ServiceClient, container string, concurrency int) error {
+	names, err := resourceObjectStorageContainerV1ListObjectNames(client, container)
+	if err != nil {
+		return err
+	}
+
+	if resourceObjectStorageContainerV1SupportsBulkDelete(client) {
+		if err := resourceObjectStorageContainerV1BulkDelete(client, container, names); err != nil {
+			return err
+		}
+	} else if err := resourceObjectStorageContainerV1ConcurrentDelete(client, container, names, concurrency); err != nil {
+		return err
+	}
+
+	segmentsContainer := container + "_segments"
+	if _, err := containers.Get(client, segmentsContainer, nil).Extract(); err == nil {
+		log.Printf("[DEBUG] Recursing into segments companion container '%s' for objectstorage_container_v1 '%s'", segmentsContainer, container)
+		if err := resourceObjectStorageContainerV1ForceDestroy(client, segmentsContainer, concurrency); err != nil {
+			return err
+		}
+		if _, err := containers.Delete(client, segmentsContainer).Extract(); err != nil {
+			return fmt.Errorf("error deleting segments container '%s': %s", segmentsContainer, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceObjectStorageContainerV1ListObjectNames(client *gophercloud.ServiceClient, container string) ([]string, error) {
+	var names []string
+
+	pager := objects.List(client, container, &objects.ListOpts{Full: false})
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		pageNames, err := objects.ExtractNames(page)
+		if err != nil {
+			return false, fmt.Errorf("error extracting names from objects from page for objectstorage_container_v1 '%s': %+v", container, err)
+		}
+		names = append(names, pageNames...)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// resourceObjectStorageContainerV1ConcurrentDelete deletes objects one-by-one, fanning the
+// work out across a bounded pool of goroutines.
+func resourceObjectStorageContainerV1ConcurrentDelete(client *gophercloud.ServiceClient, container string, names []string, concurrency int) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan string)
+	errs := make(chan error, len(names))
+	var wg sync.WaitGroup
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range jobs {
+				if _, err := objects.Delete(client, container, name, nil).Extract(); err != nil {
+					errs <- fmt.Errorf("error deleting object '%s' from objectstorage_container_v1 '%s': %+v", name, container, err)
+				}
+			}
+		}()
+	}
+
+	for _, name := range names {
+		jobs <- name
+	}
+	close(jobs)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		return err
+	}
+
+	return nil
+}
+
+// resourceObjectStorageContainerV1BulkDelete removes objects via Swift's bulk-delete
+// middleware, batching requests to the middleware's maximum path count.
+func resourceObjectStorageContainerV1BulkDelete(client *gophercloud.ServiceClient, container string, names []string) error {
+	for start := 0; start < len(names); start += bulkDeleteBatchSize {
+		end := start + bulkDeleteBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+
+		var body bytes.Buffer
+		for _, name := range names[start:end] {
+			// Percent-encode the path so spaces, "%", and newlines in the object name can't
+			// corrupt the newline-delimited request body, while keeping any literal "/" within
+			// the object name (e.g. pseudo-hierarchical or SLO segment names) unescaped.
+			escaped := (&url.URL{Path: "/" + container + "/" + name}).EscapedPath()
+			fmt.Fprintf(&body, "%s\n", escaped)
+		}
+
+		// Bulk-delete is account-scoped, so it has to hit the account's storage URL
+		// (client.ServiceURL(), which already includes e.g. "/v1/AUTH_xxx"), not the bare
+		// cluster root used by the unauthenticated "/info" capability probe below.
+		bulkDeleteURL := client.ServiceURL() + "?bulk-delete"
+		_, err := client.Request("POST", bulkDeleteURL, &gophercloud.RequestOpts{
+			RawBody:     &body,
+			OkCodes:     []int{200},
+			MoreHeaders: map[string]string{"Content-Type": "text/plain"},
+		})
+		if err != nil {
+			return fmt.Errorf("error bulk-deleting objects from objectstorage_container_v1 '%s': %s", container, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceObjectStorageContainerV1SupportsBulkDelete checks the cluster's capabilities
+// discovery endpoint ("/info") for bulk-delete middleware support.
+func resourceObjectStorageContainerV1SupportsBulkDelete(client *gophercloud.ServiceClient) bool {
+	var capabilities map[string]interface{}
+	_, err := client.Request("GET", resourceObjectStorageRootURL(client)+"info", &gophercloud.RequestOpts{
+		JSONResponse: &capabilities,
+		OkCodes:      []int{200},
+	})
+	if err != nil {
+		return false
+	}
+
+	_, ok := capabilities["bulk_delete"]
+	return ok
+}
+
+// resourceObjectStorageRootURL returns the scheme+host root of the object storage endpoint,
+// stripping the account-scoped path (e.g. "/v1/AUTH_xxx") used for regular container/object
+// operations, since the capabilities discovery endpoint ("/info") hangs off the cluster root instead.
+func resourceObjectStorageRootURL(client *gophercloud.ServiceClient) string {
+	endpoint, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return strings.TrimRight(client.Endpoint, "/") + "/"
+	}
+	return fmt.Sprintf("%s://%s/", endpoint.Scheme, endpoint.Host)
+}
+
 func resourceContainerMetadataV2(d *schema.ResourceData) map[string]string {
 	m := make(map[string]string)
 	for key, val := range d.Get("metadata").(map[string]interface{}) {
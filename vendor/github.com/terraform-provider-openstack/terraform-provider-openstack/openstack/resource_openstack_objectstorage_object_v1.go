@@ -0,0 +1,297 @@
+package openstack
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// defaultSLOSegmentSize is the default threshold, in bytes, above which
+// resourceObjectStorageObjectV1Create splits an upload into a Static Large Object.
+const defaultSLOSegmentSize = 5 * 1024 * 1024 * 1024 // 5 GiB
+
+// sloSegment is one entry of a Static Large Object manifest.
+// See https://docs.openstack.org/swift/latest/api/large_objects.html
+type sloSegment struct {
+	Path      string `json:"path"`
+	Etag      string `json:"etag"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// sloBound is the half-open byte range [offset, end) of one segment of a split upload.
+type sloBound struct {
+	offset int64
+	end    int64
+}
+
+// sloSegmentBounds splits a payload of the given length into contiguous, non-overlapping
+// [offset, end) ranges no larger than segmentSize bytes each. segmentSize must be positive, since
+// a zero stride would never advance and a negative one would produce an invalid range.
+func sloSegmentBounds(contentLen int, segmentSize int64) ([]sloBound, error) {
+	if segmentSize < 1 {
+		return nil, fmt.Errorf("segment_size must be a positive number of bytes, got %d", segmentSize)
+	}
+
+	var bounds []sloBound
+	for offset := int64(0); offset < int64(contentLen); offset += segmentSize {
+		end := offset + segmentSize
+		if end > int64(contentLen) {
+			end = int64(contentLen)
+		}
+		bounds = append(bounds, sloBound{offset: offset, end: end})
+	}
+
+	return bounds, nil
+}
+
+func resourceObjectStorageObjectV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObjectStorageObjectV1Create,
+		Read:   resourceObjectStorageObjectV1Read,
+		Delete: resourceObjectStorageObjectV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"content"},
+			},
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source"},
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"segment_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultSLOSegmentSize,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_static_large_object": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObjectStorageObjectV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+	contentType := d.Get("content_type").(string)
+
+	content, err := resourceObjectStorageObjectV1Content(d)
+	if err != nil {
+		return fmt.Errorf("error reading content for objectstorage_object_v1 '%s/%s': %s", container, name, err)
+	}
+
+	segmentSize := int64(d.Get("segment_size").(int))
+
+	var etag string
+	var isSLO bool
+	if int64(len(content)) <= segmentSize {
+		etag, err = resourceObjectStorageObjectV1Put(objectStorageClient, container, name, content, contentType)
+	} else {
+		etag, err = resourceObjectStorageObjectV1PutSLO(objectStorageClient, container, name, content, contentType, segmentSize)
+		isSLO = true
+	}
+	if err != nil {
+		return fmt.Errorf("error creating objectstorage_object_v1 '%s/%s': %s", container, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", container, name))
+	d.Set("etag", etag)
+	d.Set("is_static_large_object", isSLO)
+
+	return resourceObjectStorageObjectV1Read(d, meta)
+}
+
+func resourceObjectStorageObjectV1Content(d *schema.ResourceData) ([]byte, error) {
+	if v, ok := d.GetOk("source"); ok {
+		return ioutil.ReadFile(v.(string))
+	}
+	return []byte(d.Get("content").(string)), nil
+}
+
+// resourceObjectStorageObjectV1Put uploads content as a single, non-segmented object.
+func resourceObjectStorageObjectV1Put(client *gophercloud.ServiceClient, container, name string, content []byte, contentType string) (string, error) {
+	createOpts := objects.CreateOpts{
+		Content: bytes.NewReader(content),
+	}
+	if contentType != "" {
+		createOpts.ContentType = contentType
+	}
+
+	log.Printf("[DEBUG] Uploading objectstorage_object_v1 '%s/%s' (%d bytes)", container, name, len(content))
+	header, err := objects.Create(client, container, name, createOpts).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	return header.ETag, nil
+}
+
+// resourceObjectStorageObjectV1PutSLO splits content into segmentSize chunks uploaded to a
+// "<container>_segments" companion container, then writes the Static Large Object manifest
+// that ties them together under the requested name.
+func resourceObjectStorageObjectV1PutSLO(client *gophercloud.ServiceClient, container, name string, content []byte, contentType string, segmentSize int64) (string, error) {
+	bounds, err := sloSegmentBounds(len(content), segmentSize)
+	if err != nil {
+		return "", err
+	}
+
+	// Swift container creation is idempotent: PUT-ing an existing container just updates it,
+	// so it is safe to (re-)create the segments container unconditionally here.
+	segmentsContainer := container + "_segments"
+	if _, err := containers.Create(client, segmentsContainer, nil).Extract(); err != nil {
+		return "", fmt.Errorf("error creating segments container '%s': %s", segmentsContainer, err)
+	}
+
+	var segments []sloSegment
+	for i, bound := range bounds {
+		chunk := content[bound.offset:bound.end]
+		segmentName := fmt.Sprintf("%s/%08d", name, i)
+
+		etag, err := resourceObjectStorageObjectV1Put(client, segmentsContainer, segmentName, chunk, "")
+		if err != nil {
+			return "", fmt.Errorf("error uploading segment '%s/%s': %s", segmentsContainer, segmentName, err)
+		}
+
+		segments = append(segments, sloSegment{
+			Path:      fmt.Sprintf("%s/%s", segmentsContainer, segmentName),
+			Etag:      etag,
+			SizeBytes: int64(len(chunk)),
+		})
+	}
+
+	manifest, err := json.Marshal(segments)
+	if err != nil {
+		return "", err
+	}
+
+	url := client.ServiceURL(container, name) + "?multipart-manifest=put"
+	_, err = client.Request("PUT", url, &gophercloud.RequestOpts{
+		RawBody:          bytes.NewReader(manifest),
+		OkCodes:          []int{201},
+		MoreHeaders:      map[string]string{"Accept": "application/json"},
+		KeepResponseBody: false,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error writing SLO manifest for '%s/%s': %s", container, name, err)
+	}
+
+	return resourceObjectStorageObjectV1SLOEtag(segments), nil
+}
+
+// resourceObjectStorageObjectV1SLOEtag reproduces the ETag Swift reports for a Static Large
+// Object: the MD5, hex-encoded, of the concatenation of its segments' own ETags. This is what
+// resourceObjectStorageObjectV1Read will observe on the next refresh, so Create must agree with
+// it rather than hashing the manifest body.
+func resourceObjectStorageObjectV1SLOEtag(segments []sloSegment) string {
+	var concatenated bytes.Buffer
+	for _, segment := range segments {
+		concatenated.WriteString(segment.Etag)
+	}
+	return fmt.Sprintf("%x", md5.Sum(concatenated.Bytes()))
+}
+
+func resourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	result := objects.Get(objectStorageClient, container, name, nil)
+	if result.Err != nil {
+		return CheckDeleted(d, result.Err, "object")
+	}
+
+	header, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("error extracting headers for objectstorage_object_v1 '%s/%s': %s", container, name, err)
+	}
+
+	d.Set("content_type", header.ContentType)
+	d.Set("etag", header.ETag)
+	d.Set("is_static_large_object", result.Header.Get("X-Static-Large-Object") == "True")
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceObjectStorageObjectV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	if d.Get("is_static_large_object").(bool) {
+		url := objectStorageClient.ServiceURL(container, name) + "?multipart-manifest=delete"
+		_, err := objectStorageClient.Request("DELETE", url, &gophercloud.RequestOpts{
+			OkCodes: []int{200, 404},
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting SLO manifest and segments for objectstorage_object_v1 '%s/%s': %s", container, name, err)
+		}
+	} else if _, err := objects.Delete(objectStorageClient, container, name, nil).Extract(); err != nil {
+		return fmt.Errorf("error deleting objectstorage_object_v1 '%s/%s': %s", container, name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSLOSegmentBounds(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentLen  int
+		segmentSize int64
+		want        []sloBound
+		wantErr     bool
+	}{
+		{
+			name:        "even split",
+			contentLen:  10,
+			segmentSize: 5,
+			want:        []sloBound{{0, 5}, {5, 10}},
+		},
+		{
+			name:        "uneven split",
+			contentLen:  11,
+			segmentSize: 5,
+			want:        []sloBound{{0, 5}, {5, 10}, {10, 11}},
+		},
+		{
+			name:        "segment larger than content",
+			contentLen:  3,
+			segmentSize: 5,
+			want:        []sloBound{{0, 3}},
+		},
+		{
+			name:        "empty content",
+			contentLen:  0,
+			segmentSize: 5,
+			want:        nil,
+		},
+		{
+			name:        "zero segment size",
+			contentLen:  10,
+			segmentSize: 0,
+			wantErr:     true,
+		},
+		{
+			name:        "negative segment size",
+			contentLen:  10,
+			segmentSize: -1,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sloSegmentBounds(tt.contentLen, tt.segmentSize)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("sloSegmentBounds(%d, %d) = %v, nil; want error", tt.contentLen, tt.segmentSize, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("sloSegmentBounds(%d, %d) returned unexpected error: %s", tt.contentLen, tt.segmentSize, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sloSegmentBounds(%d, %d) = %v, want %v", tt.contentLen, tt.segmentSize, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceObjectStorageObjectV1SLOEtag(t *testing.T) {
+	segments := []sloSegment{
+		{Path: "c_segments/o/00000000", Etag: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", SizeBytes: 5},
+		{Path: "c_segments/o/00000001", Etag: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", SizeBytes: 5},
+	}
+
+	got := resourceObjectStorageObjectV1SLOEtag(segments)
+	want := "af6d2490a0fa5d84a8dc051158f8baf2"
+
+	if got != want {
+		t.Errorf("resourceObjectStorageObjectV1SLOEtag(%v) = %q, want %q", segments, got, want)
+	}
+
+	if got != resourceObjectStorageObjectV1SLOEtag(segments) {
+		t.Error("resourceObjectStorageObjectV1SLOEtag is not deterministic across calls with the same input")
+	}
+}